@@ -6,15 +6,23 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"math/big"
 	"net/http"
+	"os"
+	"reflect"
+	"regexp"
 	"strings"
+	"time"
+	"unsafe"
 
 	"github.com/yaronf/httpsign"
+	"golang.org/x/crypto/chacha20"
 )
 
 type TestVector struct {
@@ -27,6 +35,279 @@ type TestVector struct {
 	PublicKey  map[string]string `json:"publicKey"` // JWK components
 	Signature  string            `json:"signature"`
 	SigInput   string            `json:"sigInput"`
+
+	// ContentDigest is the raw `Content-Digest` header value (RFC 9530,
+	// structured-field form) set on the request before signing, when the
+	// request has a body.
+	ContentDigest string `json:"contentDigest,omitempty"`
+	// DigestValues maps each digest algorithm ("sha-256", "sha-512") to the
+	// raw (non-structured-field, base64-encoded) digest bytes, so a JS
+	// verifier can recompute and compare without re-parsing the structured
+	// field.
+	DigestValues map[string]string `json:"digestValues,omitempty"`
+	// MutatedBody, when set, is the body as it appears on the wire *after*
+	// signing — different from Body — so a verifier can be exercised
+	// against a digest mismatch.
+	MutatedBody string `json:"mutatedBody,omitempty"`
+	// ExpectedError names the rejection reason a verifier should produce
+	// for a deliberately broken vector, e.g. "digest_mismatch".
+	ExpectedError string `json:"expectedError,omitempty"`
+	// MaxAge, when set, is the freshness window in seconds a verifier
+	// should enforce against the (possibly doctored) `created` parameter
+	// in SigInput.
+	MaxAge int64 `json:"maxAge,omitempty"`
+	// Coverage enumerates exactly which derived components and header
+	// parameters this vector exercises, e.g. ["@authority", "@query",
+	// `cache-control;sf`], so a verifier's test table can be generated
+	// directly from it.
+	Coverage []string `json:"coverage,omitempty"`
+	// Signatures holds one entry per label when a message is signed under
+	// multiple labels (e.g. "sig1", "sig2") with distinct key material. When
+	// set, Signature/SigInput/Algorithm/PublicKey above describe the first
+	// entry for convenience; Signatures is authoritative.
+	Signatures []MultiSig `json:"signatures,omitempty"`
+	// Response, when set, is the signed HTTP response bound to this vector's
+	// request, so a verifier can validate request/response binding
+	// end-to-end from a single vector.
+	Response *SignedResponse `json:"response,omitempty"`
+}
+
+// MultiSig describes one labelled signature within a multi-signature
+// message, i.e. one `Signature`/`Signature-Input` entry sharing the
+// message with other labels.
+type MultiSig struct {
+	Label     string            `json:"label"`
+	Algorithm string            `json:"algorithm"`
+	PublicKey map[string]string `json:"publicKey"`
+	Signature string            `json:"signature"`
+	SigInput  string            `json:"sigInput"`
+}
+
+// SignedResponse carries a signed HTTP response, including (when bound to
+// a request) the Request-Signature-Digest header tying it to that request.
+type SignedResponse struct {
+	Status        int               `json:"status"`
+	Headers       map[string]string `json:"headers"`
+	Body          string            `json:"body,omitempty"`
+	Algorithm     string            `json:"algorithm"`
+	PublicKey     map[string]string `json:"publicKey"`
+	Signature     string            `json:"signature"`
+	SigInput      string            `json:"sigInput"`
+	Coverage      []string          `json:"coverage,omitempty"`
+	ContentDigest string            `json:"contentDigest,omitempty"`
+	DigestValues  map[string]string `json:"digestValues,omitempty"`
+}
+
+// contentDigestHeader computes an RFC 9530 `Content-Digest` header value
+// covering both sha-256 and sha-512, in structured-field byte-sequence form,
+// e.g. `sha-256=:...:, sha-512=:...:`. It also returns the individual
+// base64-encoded digest values keyed by algorithm name.
+func contentDigestHeader(body string) (header string, values map[string]string) {
+	sum256 := sha256.Sum256([]byte(body))
+	sum512 := sha512.Sum512([]byte(body))
+	d256 := base64.StdEncoding.EncodeToString(sum256[:])
+	d512 := base64.StdEncoding.EncodeToString(sum512[:])
+	header = fmt.Sprintf("sha-256=:%s:, sha-512=:%s:", d256, d512)
+	values = map[string]string{"sha-256": d256, "sha-512": d512}
+	return header, values
+}
+
+// applyContentDigest, when body is non-empty, computes and sets the RFC 9530
+// Content-Digest header on req and adds "content-digest" and "content-type"
+// to fields so the digest becomes part of the signed base string.
+func applyContentDigest(req *http.Request, body string, fields *httpsign.Fields) (header string, values map[string]string) {
+	if body == "" {
+		return "", nil
+	}
+	header, values = contentDigestHeader(body)
+	req.Header.Set("Content-Digest", header)
+	fields.AddHeader("content-digest")
+	fields.AddHeader("content-type")
+	return header, values
+}
+
+// jwkThumbprint computes the RFC 7638 JWK thumbprint: a SHA-256 digest over
+// the canonical JSON encoding (only the required members, in lexicographic
+// key order, no whitespace) of the key's public parameters.
+func jwkThumbprint(jwk map[string]string) string {
+	var canonical string
+	switch jwk["kty"] {
+	case "EC":
+		canonical = fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q,"y":%q}`, jwk["crv"], jwk["kty"], jwk["x"], jwk["y"])
+	case "OKP":
+		canonical = fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q}`, jwk["crv"], jwk["kty"], jwk["x"])
+	case "RSA":
+		canonical = fmt.Sprintf(`{"e":%q,"kty":%q,"n":%q}`, jwk["e"], jwk["kty"], jwk["n"])
+	default:
+		return ""
+	}
+	sum := sha256.Sum256([]byte(canonical))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// chachaReader exposes a ChaCha20 keystream as an io.Reader, used as a
+// deterministic randomness source for RSA key generation (see
+// deterministicRSAKey). Note that RSA keygen is only deterministic for a
+// fixed Go version: the standard library's primality test that derivePrime
+// applies to candidates from this stream is not guaranteed stable across
+// releases.
+type chachaReader struct {
+	cipher *chacha20.Cipher
+}
+
+func newChachaReader(seed string) (*chachaReader, error) {
+	h := sha256.Sum256([]byte(seed))
+	c, err := chacha20.NewUnauthenticatedCipher(h[:], make([]byte, chacha20.NonceSize))
+	if err != nil {
+		return nil, err
+	}
+	return &chachaReader{cipher: c}, nil
+}
+
+func (r *chachaReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	r.cipher.XORKeyStream(p, p)
+	return len(p), nil
+}
+
+// constantByteReader is an infinite stream of a single repeated byte. It
+// exists because crypto/internal/randutil.MaybeReadByte — called at the top
+// of ecdsa.Sign and other stdlib signing/keygen entrypoints — consumes zero
+// or one byte from its reader based on a coin flip that has nothing to do
+// with the reader's contents (a select between two cases of an
+// already-closed channel, which Go picks between uniformly at random), so
+// any reader whose output varies by position gets desynchronized between
+// runs. A reader that always yields the same byte is immune: whether
+// MaybeReadByte eats one byte or zero, every byte the caller reads next is
+// identical either way.
+type constantByteReader struct {
+	b byte
+}
+
+func (r constantByteReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = r.b
+	}
+	return len(p), nil
+}
+
+// withDeterministicNonce temporarily replaces crypto/rand.Reader with a
+// constant, seed-derived byte stream for the duration of fn, so that
+// ECDSA/RSA-PSS signing — which otherwise draws its nonce/salt from
+// crypto/rand — produces byte-stable signatures for a given seed.
+func withDeterministicNonce(seed string, fn func() error) error {
+	h := sha256.Sum256([]byte(seed + "-nonce"))
+	prev := rand.Reader
+	rand.Reader = constantByteReader{b: h[0]}
+	defer func() { rand.Reader = prev }()
+	return fn()
+}
+
+// deterministicECDSAKey derives a P-256 key pair directly from seed via
+// scalar multiplication, rather than going through ecdsa.GenerateKey.
+// GenerateKey internally calls randutil.MaybeReadByte, which by design
+// consumes a randomly chosen extra byte from its random source on roughly
+// half of all calls, specifically so callers can't rely on it being
+// deterministic for a given stream — feeding it a seeded reader therefore
+// can still yield a different key from one run to the next.
+func deterministicECDSAKey(seed string) *ecdsa.PrivateKey {
+	curve := elliptic.P256()
+	order := curve.Params().N
+	h := sha256.Sum256([]byte(seed + "-ecdsa"))
+	d := new(big.Int).Mod(new(big.Int).SetBytes(h[:]), new(big.Int).Sub(order, big.NewInt(1)))
+	d.Add(d, big.NewInt(1)) // d in [1, order-1]
+	x, y := curve.ScalarBaseMult(d.Bytes())
+	return &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{Curve: curve, X: x, Y: y},
+		D:         d,
+	}
+}
+
+// derivePrime draws bits-sized prime candidates straight from reader's
+// keystream — rather than crypto/rand.Prime, which itself calls
+// randutil.MaybeReadByte (see constantByteReader above) before reading its
+// candidate bytes, reintroducing the same nondeterminism deterministicRSAKey
+// exists to avoid — and returns the first one that passes ProbablyPrime.
+func derivePrime(reader io.Reader, bits int) (*big.Int, error) {
+	buf := make([]byte, (bits+7)/8)
+	for {
+		if _, err := io.ReadFull(reader, buf); err != nil {
+			return nil, err
+		}
+		candidate := new(big.Int).SetBytes(buf)
+		candidate.SetBit(candidate, bits-1, 1) // force the exact bit length
+		candidate.SetBit(candidate, 0, 1)      // force odd
+		if candidate.ProbablyPrime(20) {
+			return candidate, nil
+		}
+	}
+}
+
+// deterministicRSAKey derives an RSA key pair from seed by drawing its two
+// primes from a seeded ChaCha20 stream via derivePrime, rather than going
+// through rsa.GenerateKey (which hits the same randutil.MaybeReadByte
+// nondeterminism as ecdsa.GenerateKey above). Deterministic only for a fixed
+// Go version, since big.Int.ProbablyPrime's behavior isn't guaranteed
+// stable across releases.
+func deterministicRSAKey(seed string, bits int) (*rsa.PrivateKey, error) {
+	reader, err := newChachaReader(seed + "-rsa")
+	if err != nil {
+		return nil, err
+	}
+
+	var p, q *big.Int
+	for {
+		if p, err = derivePrime(reader, bits/2); err != nil {
+			return nil, err
+		}
+		if q, err = derivePrime(reader, bits/2); err != nil {
+			return nil, err
+		}
+		if p.Cmp(q) != 0 {
+			break
+		}
+	}
+
+	e := big.NewInt(0x10001)
+	phi := new(big.Int).Mul(new(big.Int).Sub(p, big.NewInt(1)), new(big.Int).Sub(q, big.NewInt(1)))
+	d := new(big.Int).ModInverse(e, phi)
+	if d == nil {
+		return nil, fmt.Errorf("deterministicRSAKey: e has no inverse mod phi(n) for seed %q", seed)
+	}
+
+	priv := &rsa.PrivateKey{
+		PublicKey: rsa.PublicKey{N: new(big.Int).Mul(p, q), E: int(e.Int64())},
+		D:         d,
+		Primes:    []*big.Int{p, q},
+	}
+	priv.Precompute()
+	return priv, nil
+}
+
+// goldenCreated is the fixed `created` timestamp stamped onto every
+// generated signature, so the emitted JSON is byte-stable across runs.
+var goldenCreated = time.Unix(1700000000, 0)
+
+// pinCreated pins config's signature creation time to created, so the
+// resulting Signature actually covers that timestamp (rather than leaving it
+// on the wall clock, which would make even fully deterministic keys produce
+// a different Signature on every run, or patching the declared `created`
+// into Signature-Input after the fact, which would leave the signature
+// covering a timestamp it was never signed under). httpsign.SignConfig only
+// exposes this as an unexported `fakeCreated` field (used by the library's
+// own tests), with no public setter, so we reach it via reflection — and
+// error out rather than silently no-op'ing if the library's internal layout
+// ever changes underneath us, since a silent no-op would quietly break the
+// determinism every generated vector depends on.
+func pinCreated(config *httpsign.SignConfig, created time.Time) error {
+	field := reflect.ValueOf(config).Elem().FieldByName("fakeCreated")
+	if !field.IsValid() || !field.CanAddr() || field.Kind() != reflect.Int64 {
+		return fmt.Errorf("pinCreated: httpsign.SignConfig.fakeCreated is missing or not an int64 (library internals changed?)")
+	}
+	*(*int64)(unsafe.Pointer(field.UnsafeAddr())) = created.Unix()
+	return nil
 }
 
 // Helper to convert big.Int to base64url
@@ -41,11 +322,10 @@ func bigIntToBase64URL(n *big.Int) string {
 	return base64.RawURLEncoding.EncodeToString(bytes)
 }
 
-func generateEd25519Vector(name, method, url, body string, headers map[string]string) (*TestVector, error) {
-	pub, priv, err := ed25519.GenerateKey(rand.Reader)
-	if err != nil {
-		return nil, err
-	}
+func generateEd25519Vector(seed, name, method, url, body string, headers map[string]string) (*TestVector, error) {
+	seedBytes := sha256.Sum256([]byte(seed))
+	priv := ed25519.NewKeyFromSeed(seedBytes[:])
+	pub := priv.Public().(ed25519.PublicKey)
 
 	var bodyReader io.Reader
 	if body != "" {
@@ -60,8 +340,20 @@ func generateEd25519Vector(name, method, url, body string, headers map[string]st
 		req.Header.Set(k, v)
 	}
 
-	config := httpsign.NewSignConfig()
+	jwk := map[string]string{
+		"kty": "OKP",
+		"crv": "Ed25519",
+		"x":   base64.RawURLEncoding.EncodeToString(pub),
+	}
+	kid := jwkThumbprint(jwk)
+	jwk["kid"] = kid
+
+	config := httpsign.NewSignConfig().SetKeyID(kid)
+	if err := pinCreated(config, goldenCreated); err != nil {
+		return nil, err
+	}
 	fields := httpsign.NewFields()
+	digestHeader, digestValues := applyContentDigest(req, body, fields)
 	signer, err := httpsign.NewEd25519Signer(priv, config, *fields)
 	if err != nil {
 		return nil, err
@@ -73,27 +365,30 @@ func generateEd25519Vector(name, method, url, body string, headers map[string]st
 	}
 
 	return &TestVector{
-		Name:      name,
-		Method:    method,
-		URL:       url,
-		Headers:   headers,
-		Body:      body,
-		Algorithm: "Ed25519",
-		PublicKey: map[string]string{
-			"kty": "OKP",
-			"crv": "Ed25519",
-			"x":   base64.RawURLEncoding.EncodeToString(pub),
-		},
-		Signature: signature,
-		SigInput:  sigInput,
+		Name:          name,
+		Method:        method,
+		URL:           url,
+		Headers:       headers,
+		Body:          body,
+		Algorithm:     "Ed25519",
+		PublicKey:     jwk,
+		Signature:     signature,
+		SigInput:      sigInput,
+		ContentDigest: digestHeader,
+		DigestValues:  digestValues,
 	}, nil
 }
 
-func generateES256Vector(name, method, url, body string, headers map[string]string) (*TestVector, error) {
-	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
-	if err != nil {
-		return nil, err
-	}
+func generateES256Vector(seed, name, method, url, body string, headers map[string]string) (*TestVector, error) {
+	return generateES256VectorAt(seed, name, method, url, body, headers, goldenCreated)
+}
+
+// generateES256VectorAt is generateES256Vector's sibling for vectors that
+// must be signed under a specific `created` timestamp rather than
+// goldenCreated, e.g. the adversarial "expired" vector, which needs a
+// signature that actually validates against the stale created it declares.
+func generateES256VectorAt(seed, name, method, url, body string, headers map[string]string, created time.Time) (*TestVector, error) {
+	priv := deterministicECDSAKey(seed)
 
 	var bodyReader io.Reader
 	if body != "" {
@@ -108,38 +403,53 @@ func generateES256Vector(name, method, url, body string, headers map[string]stri
 		req.Header.Set(k, v)
 	}
 
-	config := httpsign.NewSignConfig()
+	jwk := map[string]string{
+		"kty": "EC",
+		"crv": "P-256",
+		"x":   bigIntToBase64URL(priv.PublicKey.X),
+		"y":   bigIntToBase64URL(priv.PublicKey.Y),
+	}
+	kid := jwkThumbprint(jwk)
+	jwk["kid"] = kid
+
+	config := httpsign.NewSignConfig().SetKeyID(kid)
+	if err := pinCreated(config, created); err != nil {
+		return nil, err
+	}
 	fields := httpsign.NewFields()
+	digestHeader, digestValues := applyContentDigest(req, body, fields)
 	signer, err := httpsign.NewP256Signer(*priv, config, *fields)
 	if err != nil {
 		return nil, err
 	}
 
-	sigInput, signature, err := httpsign.SignRequest("sig", *signer, req)
+	var sigInput, signature string
+	err = withDeterministicNonce(seed, func() error {
+		var signErr error
+		sigInput, signature, signErr = httpsign.SignRequest("sig", *signer, req)
+		return signErr
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &TestVector{
-		Name:      name,
-		Method:    method,
-		URL:       url,
-		Headers:   headers,
-		Body:      body,
-		Algorithm: "ES256",
-		PublicKey: map[string]string{
-			"kty": "EC",
-			"crv": "P-256",
-			"x":   bigIntToBase64URL(priv.PublicKey.X),
-			"y":   bigIntToBase64URL(priv.PublicKey.Y),
-		},
-		Signature: signature,
-		SigInput:  sigInput,
+		Name:          name,
+		Method:        method,
+		URL:           url,
+		Headers:       headers,
+		Body:          body,
+		Algorithm:     "ES256",
+		PublicKey:     jwk,
+		Signature:     signature,
+		SigInput:      sigInput,
+		ContentDigest: digestHeader,
+		DigestValues:  digestValues,
 	}, nil
 }
 
-func generateRS256Vector(name, method, url, body string, headers map[string]string) (*TestVector, error) {
-	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+func generateRS256Vector(seed, name, method, url, body string, headers map[string]string) (*TestVector, error) {
+	priv, err := deterministicRSAKey(seed, 2048)
 	if err != nil {
 		return nil, err
 	}
@@ -157,14 +467,111 @@ func generateRS256Vector(name, method, url, body string, headers map[string]stri
 		req.Header.Set(k, v)
 	}
 
-	config := httpsign.NewSignConfig()
+	jwk := map[string]string{
+		"kty": "RSA",
+		"n":   base64.RawURLEncoding.EncodeToString(priv.PublicKey.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(priv.PublicKey.E)).Bytes()),
+	}
+	kid := jwkThumbprint(jwk)
+	jwk["kid"] = kid
+
+	config := httpsign.NewSignConfig().SetKeyID(kid)
+	if err := pinCreated(config, goldenCreated); err != nil {
+		return nil, err
+	}
 	fields := httpsign.NewFields()
+	digestHeader, digestValues := applyContentDigest(req, body, fields)
 	// NewRSAPSSSigner uses RSA-PSS with SHA-512 (matches our implementation)
 	signer, err := httpsign.NewRSAPSSSigner(*priv, config, *fields)
 	if err != nil {
 		return nil, err
 	}
 
+	var sigInput, signature string
+	err = withDeterministicNonce(seed, func() error {
+		var signErr error
+		sigInput, signature, signErr = httpsign.SignRequest("sig", *signer, req)
+		return signErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TestVector{
+		Name:          name,
+		Method:        method,
+		URL:           url,
+		Headers:       headers,
+		Body:          body,
+		Algorithm:     "RS256",
+		PublicKey:     jwk,
+		Signature:     signature,
+		SigInput:      sigInput,
+		ContentDigest: digestHeader,
+		DigestValues:  digestValues,
+	}, nil
+}
+
+// addCoverageComponent adds a single covered component or parameterized
+// header to fields, given its RFC 9421 component identifier, e.g.
+// "@method", `@query-param;name="q"`, "cache-control;sf", or
+// `dictionary;key="foo"`.
+func addCoverageComponent(fields *httpsign.Fields, component string) {
+	switch component {
+	case `@query-param;name="q"`:
+		fields.AddQueryParam("q")
+	case "cache-control;sf":
+		fields.AddStructuredField("cache-control")
+	case `dictionary;key="foo"`:
+		fields.AddDictHeader("dictionary", "foo")
+	default:
+		fields.AddHeader(component)
+	}
+}
+
+// generateCoverageVector is generateEd25519Vector's sibling for the
+// component-coverage matrix: instead of the default field set, it signs
+// exactly the derived components and parameterized headers named in
+// coverage, and records them on the resulting vector's Coverage field.
+func generateCoverageVector(seed, name, method, url, body string, headers map[string]string, coverage []string) (*TestVector, error) {
+	seedBytes := sha256.Sum256([]byte(seed))
+	priv := ed25519.NewKeyFromSeed(seedBytes[:])
+	pub := priv.Public().(ed25519.PublicKey)
+
+	var bodyReader io.Reader
+	if body != "" {
+		bodyReader = strings.NewReader(body)
+	}
+	req, err := http.NewRequest(method, url, bodyReader)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	jwk := map[string]string{
+		"kty": "OKP",
+		"crv": "Ed25519",
+		"x":   base64.RawURLEncoding.EncodeToString(pub),
+	}
+	kid := jwkThumbprint(jwk)
+	jwk["kid"] = kid
+
+	config := httpsign.NewSignConfig().SetKeyID(kid)
+	if err := pinCreated(config, goldenCreated); err != nil {
+		return nil, err
+	}
+	fields := httpsign.NewFields()
+	for _, component := range coverage {
+		addCoverageComponent(fields, component)
+	}
+	signer, err := httpsign.NewEd25519Signer(priv, config, *fields)
+	if err != nil {
+		return nil, err
+	}
+
 	sigInput, signature, err := httpsign.SignRequest("sig", *signer, req)
 	if err != nil {
 		return nil, err
@@ -176,59 +583,651 @@ func generateRS256Vector(name, method, url, body string, headers map[string]stri
 		URL:       url,
 		Headers:   headers,
 		Body:      body,
-		Algorithm: "RS256",
-		PublicKey: map[string]string{
-			"kty": "RSA",
-			"n":   base64.RawURLEncoding.EncodeToString(priv.PublicKey.N.Bytes()),
-			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(priv.PublicKey.E)).Bytes()),
-		},
+		Algorithm: "Ed25519",
+		PublicKey: jwk,
 		Signature: signature,
 		SigInput:  sigInput,
+		Coverage:  coverage,
 	}, nil
 }
 
+// generateMultiSignatureVector signs the same request under two labels —
+// "sig1" with an Ed25519 key, "sig2" with an ES256 key — so a verifier can
+// be exercised against a message carrying more than one Signature entry.
+func generateMultiSignatureVector(seed1, seed2, name, method, url string) (*TestVector, error) {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	seedBytes := sha256.Sum256([]byte(seed1))
+	priv1 := ed25519.NewKeyFromSeed(seedBytes[:])
+	pub1 := priv1.Public().(ed25519.PublicKey)
+	jwk1 := map[string]string{
+		"kty": "OKP",
+		"crv": "Ed25519",
+		"x":   base64.RawURLEncoding.EncodeToString(pub1),
+	}
+	kid1 := jwkThumbprint(jwk1)
+	jwk1["kid"] = kid1
+	config1 := httpsign.NewSignConfig().SetKeyID(kid1)
+	if err := pinCreated(config1, goldenCreated); err != nil {
+		return nil, err
+	}
+	signer1, err := httpsign.NewEd25519Signer(priv1, config1, *httpsign.NewFields())
+	if err != nil {
+		return nil, err
+	}
+	sigInput1, signature1, err := httpsign.SignRequest("sig1", *signer1, req)
+	if err != nil {
+		return nil, err
+	}
+
+	priv2 := deterministicECDSAKey(seed2)
+	jwk2 := map[string]string{
+		"kty": "EC",
+		"crv": "P-256",
+		"x":   bigIntToBase64URL(priv2.PublicKey.X),
+		"y":   bigIntToBase64URL(priv2.PublicKey.Y),
+	}
+	kid2 := jwkThumbprint(jwk2)
+	jwk2["kid"] = kid2
+	config2 := httpsign.NewSignConfig().SetKeyID(kid2)
+	if err := pinCreated(config2, goldenCreated); err != nil {
+		return nil, err
+	}
+	signer2, err := httpsign.NewP256Signer(*priv2, config2, *httpsign.NewFields())
+	if err != nil {
+		return nil, err
+	}
+	var sigInput2, signature2 string
+	err = withDeterministicNonce(seed2, func() error {
+		var signErr error
+		sigInput2, signature2, signErr = httpsign.SignRequest("sig2", *signer2, req)
+		return signErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TestVector{
+		Name:      name,
+		Method:    method,
+		URL:       url,
+		Headers:   map[string]string{},
+		Algorithm: "Ed25519",
+		PublicKey: jwk1,
+		Signature: signature1,
+		SigInput:  sigInput1,
+		Signatures: []MultiSig{
+			{Label: "sig1", Algorithm: "Ed25519", PublicKey: jwk1, Signature: signature1, SigInput: sigInput1},
+			{Label: "sig2", Algorithm: "ES256", PublicKey: jwk2, Signature: signature2, SigInput: sigInput2},
+		},
+	}, nil
+}
+
+// requestBindingDigest computes a sha-256 structured-field digest, in the
+// same `sha-256=:...:` form as Content-Digest, over the request's Signature
+// header value. Set as the response's Request-Signature-Digest header and
+// included in what the response signs, it ties the response to the exact
+// signed request it answers — standing in for RFC 9421's `@request-response`
+// derived component, which github.com/yaronf/httpsign does not implement.
+func requestBindingDigest(reqSignature string) string {
+	sum := sha256.Sum256([]byte(reqSignature))
+	return fmt.Sprintf("sha-256=:%s:", base64.StdEncoding.EncodeToString(sum[:]))
+}
+
+// generateResponseVector signs an *http.Response with the given algorithm
+// ("Ed25519", "ES256", or "RS256"), binding it to the request it answers by
+// covering a Request-Signature-Digest header (see requestBindingDigest), and
+// returns a vector carrying both the signed request and the signed response
+// so a verifier can validate the binding end-to-end.
+func generateResponseVector(seed, name, algorithm, method, url string, status int, respBody string, respHeaders map[string]string) (*TestVector, error) {
+	reqVector, err := generateEd25519Vector(seed+"-req", name, method, url, "", map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Signature-Input", reqVector.SigInput)
+	req.Header.Set("Signature", reqVector.Signature)
+
+	res := &http.Response{
+		StatusCode: status,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(respBody)),
+	}
+	for k, v := range respHeaders {
+		res.Header.Set(k, v)
+	}
+	bindingDigest := requestBindingDigest(reqVector.Signature)
+	res.Header.Set("Request-Signature-Digest", bindingDigest)
+
+	fields := httpsign.NewFields()
+	fields.AddHeader("@status")
+	fields.AddHeader("request-signature-digest")
+	respDigestHeader := ""
+	var respDigestValues map[string]string
+	if respBody != "" {
+		respDigestHeader, respDigestValues = contentDigestHeader(respBody)
+		res.Header.Set("Content-Digest", respDigestHeader)
+		fields.AddHeader("content-digest")
+	}
+
+	var jwk map[string]string
+	var sigInput, signature string
+
+	switch algorithm {
+	case "Ed25519":
+		seedBytes := sha256.Sum256([]byte(seed))
+		priv := ed25519.NewKeyFromSeed(seedBytes[:])
+		pub := priv.Public().(ed25519.PublicKey)
+		jwk = map[string]string{"kty": "OKP", "crv": "Ed25519", "x": base64.RawURLEncoding.EncodeToString(pub)}
+		kid := jwkThumbprint(jwk)
+		jwk["kid"] = kid
+		config := httpsign.NewSignConfig().SetKeyID(kid)
+		if err := pinCreated(config, goldenCreated); err != nil {
+			return nil, err
+		}
+		signer, err := httpsign.NewEd25519Signer(priv, config, *fields)
+		if err != nil {
+			return nil, err
+		}
+		sigInput, signature, err = httpsign.SignResponse("sig1", *signer, res, req)
+		if err != nil {
+			return nil, err
+		}
+	case "ES256":
+		priv := deterministicECDSAKey(seed)
+		jwk = map[string]string{"kty": "EC", "crv": "P-256", "x": bigIntToBase64URL(priv.PublicKey.X), "y": bigIntToBase64URL(priv.PublicKey.Y)}
+		kid := jwkThumbprint(jwk)
+		jwk["kid"] = kid
+		config := httpsign.NewSignConfig().SetKeyID(kid)
+		if err := pinCreated(config, goldenCreated); err != nil {
+			return nil, err
+		}
+		signer, err := httpsign.NewP256Signer(*priv, config, *fields)
+		if err != nil {
+			return nil, err
+		}
+		err = withDeterministicNonce(seed, func() error {
+			var signErr error
+			sigInput, signature, signErr = httpsign.SignResponse("sig1", *signer, res, req)
+			return signErr
+		})
+		if err != nil {
+			return nil, err
+		}
+	case "RS256":
+		priv, err := deterministicRSAKey(seed, 2048)
+		if err != nil {
+			return nil, err
+		}
+		jwk = map[string]string{"kty": "RSA", "n": base64.RawURLEncoding.EncodeToString(priv.PublicKey.N.Bytes()), "e": base64.RawURLEncoding.EncodeToString(big.NewInt(int64(priv.PublicKey.E)).Bytes())}
+		kid := jwkThumbprint(jwk)
+		jwk["kid"] = kid
+		config := httpsign.NewSignConfig().SetKeyID(kid)
+		if err := pinCreated(config, goldenCreated); err != nil {
+			return nil, err
+		}
+		signer, err := httpsign.NewRSAPSSSigner(*priv, config, *fields)
+		if err != nil {
+			return nil, err
+		}
+		err = withDeterministicNonce(seed, func() error {
+			var signErr error
+			sigInput, signature, signErr = httpsign.SignResponse("sig1", *signer, res, req)
+			return signErr
+		})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("generateResponseVector: unknown algorithm %q", algorithm)
+	}
+
+	respHeadersOut := map[string]string{}
+	for k := range respHeaders {
+		respHeadersOut[k] = res.Header.Get(k)
+	}
+	respHeadersOut["Request-Signature-Digest"] = bindingDigest
+	coverage := []string{"@status", "request-signature-digest"}
+	if respDigestHeader != "" {
+		respHeadersOut["Content-Digest"] = respDigestHeader
+		coverage = append(coverage, "content-digest")
+	}
+
+	return &TestVector{
+		Name:      name,
+		Method:    method,
+		URL:       url,
+		Headers:   reqVector.Headers,
+		Algorithm: reqVector.Algorithm,
+		PublicKey: reqVector.PublicKey,
+		Signature: reqVector.Signature,
+		SigInput:  reqVector.SigInput,
+		Response: &SignedResponse{
+			Status:        status,
+			Headers:       respHeadersOut,
+			Body:          respBody,
+			Algorithm:     algorithm,
+			PublicKey:     jwk,
+			Signature:     signature,
+			SigInput:      sigInput,
+			Coverage:      coverage,
+			ContentDigest: respDigestHeader,
+			DigestValues:  respDigestValues,
+		},
+	}, nil
+}
+
+// generateDigestMismatchVector signs originalBody normally, then reports
+// mutatedBody as what actually went over the wire, so a verifier can be
+// exercised against a Content-Digest mismatch.
+func generateDigestMismatchVector(seed, name, method, url, originalBody, mutatedBody string, headers map[string]string) (*TestVector, error) {
+	tv, err := generateEd25519Vector(seed, name, method, url, originalBody, headers)
+	if err != nil {
+		return nil, err
+	}
+	tv.MutatedBody = mutatedBody
+	tv.ExpectedError = "digest_mismatch"
+	return tv, nil
+}
+
+var sigFieldValueRe = regexp.MustCompile(`^(\w+)=:([^:]+):$`)
+
+// flipSignatureBit flips the low bit of the first byte of the signature
+// carried in sig (a structured-field value of the form `<label>=:<base64>:`,
+// as returned by httpsign.SignRequest/SignResponse), producing a well-formed
+// but cryptographically invalid signature.
+func flipSignatureBit(sig string) (string, error) {
+	m := sigFieldValueRe.FindStringSubmatch(sig)
+	if m == nil {
+		return "", fmt.Errorf("flipSignatureBit: %q is not a <label>=:<base64>: structured field", sig)
+	}
+	raw, err := base64.StdEncoding.DecodeString(m[2])
+	if err != nil {
+		return "", fmt.Errorf("flipSignatureBit: %w", err)
+	}
+	raw[0] ^= 0x01
+	return fmt.Sprintf("%s=:%s:", m[1], base64.StdEncoding.EncodeToString(raw)), nil
+}
+
+var keyIDParamRe = regexp.MustCompile(`keyid="[^"]*"`)
+
+// withKeyID replaces the `keyid` signature parameter in sigInput with kid,
+// for adversarial vectors that need Signature-Input's keyid to point at a
+// different key than the one that actually produced the signature.
+func withKeyID(sigInput, kid string) string {
+	return keyIDParamRe.ReplaceAllString(sigInput, fmt.Sprintf(`keyid=%q`, kid))
+}
+
+// ed25519PubFromJWK extracts the raw Ed25519 public key from a JWK produced
+// by generateEd25519Vector.
+func ed25519PubFromJWK(jwk map[string]string) (ed25519.PublicKey, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(jwk["x"])
+	if err != nil {
+		return nil, fmt.Errorf("ed25519PubFromJWK: %w", err)
+	}
+	return ed25519.PublicKey(raw), nil
+}
+
+// verifierForJWK builds the httpsign.Verifier a JWKS-lookup-based verifier
+// would construct for jwk, choosing the algorithm from the JWK's own `kty`
+// rather than trusting a caller-supplied label — the same way a real
+// verifier has nothing but the looked-up key to go on. `crv` is not checked:
+// an OKP key is taken as Ed25519 and an EC key as P-256.
+func verifierForJWK(jwk map[string]string) (*httpsign.Verifier, error) {
+	switch jwk["kty"] {
+	case "OKP":
+		pub, err := ed25519PubFromJWK(jwk)
+		if err != nil {
+			return nil, err
+		}
+		return httpsign.NewEd25519Verifier(pub, nil, *httpsign.NewFields())
+	case "EC":
+		x, err := base64.RawURLEncoding.DecodeString(jwk["x"])
+		if err != nil {
+			return nil, fmt.Errorf("verifierForJWK: %w", err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(jwk["y"])
+		if err != nil {
+			return nil, fmt.Errorf("verifierForJWK: %w", err)
+		}
+		pub := ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		return httpsign.NewP256Verifier(pub, nil, *httpsign.NewFields())
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk["n"])
+		if err != nil {
+			return nil, fmt.Errorf("verifierForJWK: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk["e"])
+		if err != nil {
+			return nil, fmt.Errorf("verifierForJWK: %w", err)
+		}
+		pub := rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		return httpsign.NewRSAPSSVerifier(pub, nil, *httpsign.NewFields())
+	default:
+		return nil, fmt.Errorf("verifierForJWK: unknown kty %q", jwk["kty"])
+	}
+}
+
+// verifyRejects reconstructs the request tv describes — using sigInput and
+// signature, which may be doctored — and returns an error unless verifier
+// actually rejects it. It guards against shipping an "adversarial" vector
+// whose tamper didn't, in fact, break verification.
+func verifyRejects(tv *TestVector, verifier *httpsign.Verifier, sigInput, signature string) error {
+	var bodyReader io.Reader
+	if tv.Body != "" {
+		bodyReader = strings.NewReader(tv.Body)
+	}
+	req, err := http.NewRequest(tv.Method, tv.URL, bodyReader)
+	if err != nil {
+		return err
+	}
+	for k, v := range tv.Headers {
+		req.Header.Set(k, v)
+	}
+	if tv.ContentDigest != "" {
+		req.Header.Set("Content-Digest", tv.ContentDigest)
+	}
+	req.Header.Set("Signature-Input", sigInput)
+	req.Header.Set("Signature", signature)
+
+	if httpsign.VerifyRequest("sig", *verifier, req) == nil {
+		return fmt.Errorf("%s: expected verification to fail, but it succeeded", tv.Name)
+	}
+	return nil
+}
+
+// generateAdversarialVectors builds the companion suite of deliberately
+// broken vectors described for the negative test-vector matrix: a flipped
+// signature byte, a stale `created` timestamp, a Signature-Input whose
+// covered-component list doesn't match what was actually signed, an
+// algorithm/key substitution in both directions, and a truncated RSA
+// modulus. Each carries an ExpectedError so table-driven JS tests can
+// assert the specific rejection reason.
+func generateAdversarialVectors() ([]*TestVector, error) {
+	var out []*TestVector
+
+	// (a) Signature bytes flipped in one position.
+	bitFlip, err := generateEd25519Vector("ed25519-bitflip", "Ed25519: flipped signature bit", "GET", "https://example.com/api/data", "", map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	bitFlip.Name = "Ed25519: flipped signature bit"
+	flipped, err := flipSignatureBit(bitFlip.Signature)
+	if err != nil {
+		return nil, err
+	}
+	bitFlip.Signature = flipped
+	bitFlip.ExpectedError = "signature_mismatch"
+	bitFlipVerifier, err := verifierForJWK(bitFlip.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyRejects(bitFlip, bitFlipVerifier, bitFlip.SigInput, bitFlip.Signature); err != nil {
+		return nil, err
+	}
+	out = append(out, bitFlip)
+
+	// (b) `created` far in the past, with a maxAge a verifier should enforce.
+	// Signed directly under the stale created (rather than patched into
+	// SigInput afterward) so the signature is actually valid for the
+	// timestamp it declares, and only the freshness check fails.
+	expired, err := generateES256VectorAt("es256-expired", "ES256: expired signature", "GET", "https://example.com/api/data", "", map[string]string{}, time.Unix(1000000000, 0))
+	if err != nil {
+		return nil, err
+	}
+	expired.Name = "ES256: expired signature"
+	expired.MaxAge = 300
+	expired.ExpectedError = "expired"
+	out = append(out, expired)
+
+	// (c) Signature-Input claims @authority is covered, but it was never
+	// part of the base string that was actually signed.
+	mismatch, err := generateRS256Vector("rs256-mismatch", "RS256: covered-components mismatch", "GET", "https://example.com/api/data", "", map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	mismatch.Name = "RS256: covered-components mismatch"
+	mismatch.SigInput = strings.Replace(mismatch.SigInput, "sig=(", `sig=("@authority" `, 1)
+	mismatch.ExpectedError = "covered_components_mismatch"
+	out = append(out, mismatch)
+
+	// (d) Algorithm/key substitution: present an ES256 signature alongside
+	// an RS256 JWK, and vice versa.
+	es256, err := generateES256Vector("es256-substitution", "ES256: substitution donor", "GET", "https://example.com/api/data", "", map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	rs256, err := generateRS256Vector("rs256-substitution", "RS256: substitution donor", "GET", "https://example.com/api/data", "", map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	// Swapping PublicKey alone isn't enough: a verifier following the
+	// keyid→JWKS lookup design (see the `kid` thumbprints emitted into
+	// jwks.json) still resolves SigInput's own keyid to the original,
+	// correct signer. Point keyid at the substituted key too, so a JWKS
+	// lookup actually returns a key of the wrong type/algorithm.
+	esWithRSKey := *es256
+	esWithRSKey.Name = "ES256 signature presented with an RS256 JWK"
+	esWithRSKey.PublicKey = rs256.PublicKey
+	esWithRSKey.SigInput = withKeyID(esWithRSKey.SigInput, rs256.PublicKey["kid"])
+	esWithRSKey.ExpectedError = "algorithm_mismatch"
+	esWithRSVerifier, err := verifierForJWK(esWithRSKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyRejects(&esWithRSKey, esWithRSVerifier, esWithRSKey.SigInput, esWithRSKey.Signature); err != nil {
+		return nil, err
+	}
+	out = append(out, &esWithRSKey)
+
+	rsWithESKey := *rs256
+	rsWithESKey.Name = "RS256 signature presented with an ES256 JWK"
+	rsWithESKey.PublicKey = es256.PublicKey
+	rsWithESKey.SigInput = withKeyID(rsWithESKey.SigInput, es256.PublicKey["kid"])
+	rsWithESKey.ExpectedError = "algorithm_mismatch"
+	rsWithESVerifier, err := verifierForJWK(rsWithESKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyRejects(&rsWithESKey, rsWithESVerifier, rsWithESKey.SigInput, rsWithESKey.Signature); err != nil {
+		return nil, err
+	}
+	out = append(out, &rsWithESKey)
+
+	// (e) Truncated RSA modulus in the JWK.
+	truncated, err := generateRS256Vector("rs256-truncated", "RS256: truncated modulus", "GET", "https://example.com/api/data", "", map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	truncatedJWK := map[string]string{}
+	for k, v := range truncated.PublicKey {
+		truncatedJWK[k] = v
+	}
+	n := truncatedJWK["n"]
+	if len(n) > 16 {
+		truncatedJWK["n"] = n[:len(n)-16]
+	}
+	truncated.Name = "RS256: truncated modulus"
+	truncated.PublicKey = truncatedJWK
+	truncated.ExpectedError = "truncated_key"
+	out = append(out, truncated)
+
+	return out, nil
+}
+
 func main() {
 	vectors := []*TestVector{}
 
-	// Ed25519 vectors
-	tv1, _ := generateEd25519Vector("Ed25519: GET request", "GET", "https://example.com/api/data", "", map[string]string{})
+	// Ed25519 vectors — each keyed off an explicit seed so the emitted JSON
+	// is a stable golden file across runs.
+	tv1, err1 := generateEd25519Vector("ed25519-get", "Ed25519: GET request", "GET", "https://example.com/api/data", "", map[string]string{})
+	if err1 != nil {
+		fmt.Printf("Error generating Ed25519 vector 1: %v\n", err1)
+	}
 	vectors = append(vectors, tv1)
 
-	tv2, _ := generateEd25519Vector("Ed25519: POST with JSON", "POST", "https://example.com/api/users", `{"name":"Alice"}`, map[string]string{"Content-Type": "application/json"})
+	tv2, err2 := generateEd25519Vector("ed25519-post", "Ed25519: POST with JSON", "POST", "https://example.com/api/users", `{"name":"Alice"}`, map[string]string{"Content-Type": "application/json"})
+	if err2 != nil {
+		fmt.Printf("Error generating Ed25519 vector 2: %v\n", err2)
+	}
 	vectors = append(vectors, tv2)
 
-	tv3, _ := generateEd25519Vector("Ed25519: DELETE request", "DELETE", "https://example.com/api/resource/99", "", map[string]string{})
+	tv3, err3 := generateEd25519Vector("ed25519-delete", "Ed25519: DELETE request", "DELETE", "https://example.com/api/resource/99", "", map[string]string{})
+	if err3 != nil {
+		fmt.Printf("Error generating Ed25519 vector 3: %v\n", err3)
+	}
 	vectors = append(vectors, tv3)
 
 	// ES256 vectors
-	tv4, _ := generateES256Vector("ES256: GET request", "GET", "https://example.com/api/data", "", map[string]string{})
+	tv4, err4 := generateES256Vector("es256-get", "ES256: GET request", "GET", "https://example.com/api/data", "", map[string]string{})
+	if err4 != nil {
+		fmt.Printf("Error generating ES256 vector 1: %v\n", err4)
+	}
 	vectors = append(vectors, tv4)
 
-	tv5, _ := generateES256Vector("ES256: POST with JSON", "POST", "https://example.com/api/users", `{"name":"Bob"}`, map[string]string{"Content-Type": "application/json"})
+	tv5, err5 := generateES256Vector("es256-post", "ES256: POST with JSON", "POST", "https://example.com/api/users", `{"name":"Bob"}`, map[string]string{"Content-Type": "application/json"})
+	if err5 != nil {
+		fmt.Printf("Error generating ES256 vector 2: %v\n", err5)
+	}
 	vectors = append(vectors, tv5)
 
-	tv6, _ := generateES256Vector("ES256: PUT request", "PUT", "https://example.com/api/resource/42", `{"status":"updated"}`, map[string]string{"Content-Type": "application/json"})
+	tv6, err6 := generateES256Vector("es256-put", "ES256: PUT request", "PUT", "https://example.com/api/resource/42", `{"status":"updated"}`, map[string]string{"Content-Type": "application/json"})
+	if err6 != nil {
+		fmt.Printf("Error generating ES256 vector 3: %v\n", err6)
+	}
 	vectors = append(vectors, tv6)
 
 	// RS256 vectors
-	tv7, err7 := generateRS256Vector("RS256: GET request", "GET", "https://example.com/api/data", "", map[string]string{})
+	tv7, err7 := generateRS256Vector("rs256-get", "RS256: GET request", "GET", "https://example.com/api/data", "", map[string]string{})
 	if err7 != nil {
 		fmt.Printf("Error generating RS256 vector 1: %v\n", err7)
 	}
 	vectors = append(vectors, tv7)
 
-	tv8, err8 := generateRS256Vector("RS256: POST with JSON", "POST", "https://example.com/api/users", `{"name":"Charlie"}`, map[string]string{"Content-Type": "application/json"})
+	tv8, err8 := generateRS256Vector("rs256-post", "RS256: POST with JSON", "POST", "https://example.com/api/users", `{"name":"Charlie"}`, map[string]string{"Content-Type": "application/json"})
 	if err8 != nil {
 		fmt.Printf("Error generating RS256 vector 2: %v\n", err8)
 	}
 	vectors = append(vectors, tv8)
 
-	tv9, err9 := generateRS256Vector("RS256: GET with query", "GET", "https://example.com/search?q=test", "", map[string]string{})
+	tv9, err9 := generateRS256Vector("rs256-query", "RS256: GET with query", "GET", "https://example.com/search?q=test", "", map[string]string{})
 	if err9 != nil {
 		fmt.Printf("Error generating RS256 vector 3: %v\n", err9)
 	}
 	vectors = append(vectors, tv9)
 
+	// Negative vector: body mutated after signing, so the Content-Digest
+	// header no longer matches what a verifier recomputes.
+	tv10, err10 := generateDigestMismatchVector("ed25519-mutated", "Ed25519: body mutated after signing", "POST", "https://example.com/api/users", `{"name":"Alice"}`, `{"name":"Mallory"}`, map[string]string{"Content-Type": "application/json"})
+	if err10 != nil {
+		fmt.Printf("Error generating digest-mismatch vector: %v\n", err10)
+	}
+	vectors = append(vectors, tv10)
+
+	// Negative vector: the Signature-Input's keyid references a kid that is
+	// deliberately withheld from jwks.json, so a verifier exercises key
+	// lookup failure rather than signature verification failure.
+	tv11, err11 := generateEd25519Vector("ed25519-unknown-kid", "Ed25519: unknown keyid", "GET", "https://example.com/api/data", "", map[string]string{})
+	if err11 != nil {
+		fmt.Printf("Error generating unknown-kid vector: %v\n", err11)
+	} else {
+		tv11.ExpectedError = "unknown_key"
+	}
+	vectors = append(vectors, tv11)
+
+	// Adversarial suite: flipped signature bit, stale created timestamp,
+	// covered-components mismatch, algorithm/key substitution, truncated key.
+	adversarial, err := generateAdversarialVectors()
+	if err != nil {
+		fmt.Printf("Error generating adversarial vectors: %v\n", err)
+	}
+	vectors = append(vectors, adversarial...)
+
+	// Component-coverage matrix: one vector per derived component, plus the
+	// structured-field-parameter cases.
+	coverageMatrix := []struct {
+		seed     string
+		name     string
+		coverage []string
+	}{
+		{"cov-method", "Coverage: @method", []string{"@method"}},
+		{"cov-target-uri", "Coverage: @target-uri", []string{"@target-uri"}},
+		{"cov-authority", "Coverage: @authority", []string{"@authority"}},
+		{"cov-scheme", "Coverage: @scheme", []string{"@scheme"}},
+		{"cov-path", "Coverage: @path", []string{"@path"}},
+		{"cov-query", "Coverage: @query", []string{"@query"}},
+		{"cov-query-param", `Coverage: @query-param;name="q"`, []string{`@query-param;name="q"`}},
+		{"cov-sf-dict", "Coverage: sf Cache-Control + Dictionary member", []string{"cache-control;sf", `dictionary;key="foo"`}},
+	}
+	for _, c := range coverageMatrix {
+		headers := map[string]string{}
+		url := "https://example.com/api/data?q=test"
+		if c.seed == "cov-sf-dict" {
+			headers["Cache-Control"] = "max-age=60, must-revalidate"
+			headers["Dictionary"] = `foo=1, bar=2`
+		}
+		cv, err := generateCoverageVector(c.seed, c.name, "GET", url, "", headers, c.coverage)
+		if err != nil {
+			fmt.Printf("Error generating coverage vector %q: %v\n", c.name, err)
+			continue
+		}
+		vectors = append(vectors, cv)
+	}
+
+	// Multi-signature vector: same request, two labels, two algorithms.
+	multiSig, err := generateMultiSignatureVector("multisig-sig1", "multisig-sig2", "Multi-signature: sig1 (Ed25519) + sig2 (ES256)", "GET", "https://example.com/api/data")
+	if err != nil {
+		fmt.Printf("Error generating multi-signature vector: %v\n", err)
+	} else {
+		vectors = append(vectors, multiSig)
+	}
+
+	// Response-signing vectors, bound to their request via a covered
+	// Request-Signature-Digest header.
+	responseMatrix := []struct {
+		seed     string
+		name     string
+		alg      string
+		status   int
+		respBody string
+	}{
+		{"resp-ed25519", "Ed25519: signed response to GET", "Ed25519", 200, `{"id":1,"name":"Alice"}`},
+		{"resp-es256", "ES256: signed response to GET", "ES256", 200, `{"id":1,"name":"Alice"}`},
+		{"resp-rs256", "RS256: signed 404 response", "RS256", 404, `{"error":"not found"}`},
+	}
+	for _, r := range responseMatrix {
+		rv, err := generateResponseVector(r.seed, r.name, r.alg, "GET", "https://example.com/api/data", r.status, r.respBody, map[string]string{"Content-Type": "application/json"})
+		if err != nil {
+			fmt.Printf("Error generating response vector %q: %v\n", r.name, err)
+			continue
+		}
+		vectors = append(vectors, rv)
+	}
+
+	jwks := map[string]map[string]string{}
+	for _, tv := range vectors {
+		if tv == nil || tv.ExpectedError == "unknown_key" {
+			continue
+		}
+		jwks[tv.PublicKey["kid"]] = tv.PublicKey
+		for _, ms := range tv.Signatures {
+			jwks[ms.PublicKey["kid"]] = ms.PublicKey
+		}
+		if tv.Response != nil {
+			jwks[tv.Response.PublicKey["kid"]] = tv.Response.PublicKey
+		}
+	}
+	jwksOutput, _ := json.MarshalIndent(jwks, "", "  ")
+	if err := os.WriteFile("jwks.json", jwksOutput, 0644); err != nil {
+		fmt.Printf("Error writing jwks.json: %v\n", err)
+	}
+
 	output, _ := json.MarshalIndent(vectors, "", "  ")
 	fmt.Println(string(output))
 }